@@ -159,3 +159,174 @@ var errCmd = map[ErrCommand]string{
 	0x3F: "MAC Connection Failed",
 	0x40: "Coarse Clock Adjustment Rejected but Will Try to Adjust Using Clock Dragging",
 }
+
+// Name returns the identifier used for e in the Bluetooth spec's error
+// table (e.g. ErrCommand(0x3D).Name() == "MIC_FAILURE"), for logging and
+// metrics where the numeric code alone isn't useful.
+func (e ErrCommand) Name() string {
+	if s, ok := errCmdName[e]; ok {
+		return s
+	}
+	return errCmdName[0x1F]
+}
+
+var errCmdName = map[ErrCommand]string{
+	0x01: "UNKNOWN_COMMAND",
+	0x02: "UNKNOWN_CONN_ID",
+	0x03: "HARDWARE_FAILURE",
+	0x04: "PAGE_TIMEOUT",
+	0x05: "AUTH_FAILURE",
+	0x06: "PIN_OR_KEY_MISSING",
+	0x07: "MEMORY_CAPACITY_EXCEEDED",
+	0x08: "CONN_TIMEOUT",
+	0x09: "CONN_LIMIT_EXCEEDED",
+	0x0A: "SCO_CONN_LIMIT_EXCEEDED",
+	0x0B: "ACL_CONN_EXISTS",
+	0x0C: "COMMAND_DISALLOWED",
+	0x0D: "LIMITED_RESOURCES",
+	0x0E: "CONN_REJECTED_SECURITY",
+	0x0F: "UNACCEPTABLE_BDADDR",
+	0x10: "CONN_ACCEPT_TIMEOUT",
+	0x11: "UNSUPPORTED_FEATURE",
+	0x12: "INVALID_PARAMS",
+	0x13: "REMOTE_USER_TERMINATED",
+	0x14: "REMOTE_LOW_RESOURCES",
+	0x15: "REMOTE_POWER_OFF",
+	0x16: "LOCAL_HOST_TERMINATED",
+	0x17: "REPEATED_ATTEMPTS",
+	0x18: "PAIRING_NOT_ALLOWED",
+	0x19: "UNKNOWN_LMP_PDU",
+	0x1A: "UNSUPPORTED_REMOTE_FEATURE",
+	0x1B: "SCO_OFFSET_REJECTED",
+	0x1C: "SCO_INTERVAL_REJECTED",
+	0x1D: "SCO_AIR_MODE_REJECTED",
+	0x1E: "INVALID_LL_PARAMS",
+	0x1F: "UNSPECIFIED",
+	0x20: "UNSUPPORTED_LL_PARAM_VALUE",
+	0x21: "ROLE_CHANGE_NOT_ALLOWED",
+	0x22: "LL_RESPONSE_TIMEOUT",
+	0x23: "LMP_TRANSACTION_COLLISION",
+	0x24: "LMP_PDU_NOT_ALLOWED",
+	0x25: "ENCRYPTION_MODE_NOT_ACCEPTABLE",
+	0x26: "LINK_KEY_CANNOT_BE_CHANGED",
+	0x27: "QOS_NOT_SUPPORTED",
+	0x28: "INSTANT_PASSED",
+	0x29: "UNIT_KEY_NOT_SUPPORTED",
+	0x2A: "DIFFERENT_TRANSACTION_COLLISION",
+	0x2B: "RESERVED",
+	0x2C: "QOS_UNACCEPTABLE_PARAMETER",
+	0x2D: "QOS_REJECTED",
+	0x2E: "CHANNEL_CLASSIFICATION_NOT_SUPPORTED",
+	0x2F: "INSUFFICIENT_SECURITY",
+	0x30: "PARAMETER_OUT_OF_RANGE",
+	0x31: "RESERVED",
+	0x32: "ROLE_SWITCH_PENDING",
+	0x33: "RESERVED",
+	0x34: "RESERVED_SLOT_VIOLATION",
+	0x35: "ROLE_SWITCH_FAILED",
+	0x36: "EIR_TOO_LARGE",
+	0x37: "SECURE_SIMPLE_PAIRING_NOT_SUPPORTED",
+	0x38: "HOST_BUSY_PAIRING",
+	0x39: "NO_SUITABLE_CHANNEL",
+	0x3A: "CONTROLLER_BUSY",
+	0x3B: "UNACCEPTABLE_CONN_PARAMS",
+	0x3C: "DIRECTED_ADV_TIMEOUT",
+	0x3D: "MIC_FAILURE",
+	0x3E: "CONN_FAILED_TO_ESTABLISH",
+	0x3F: "MAC_CONN_FAILED",
+	0x40: "COARSE_CLOCK_ADJUSTMENT_REJECTED",
+}
+
+// ErrCategory classifies ErrCommand codes into the broad classes a caller
+// cares about when deciding whether to retry, back off, or give up, so
+// callers don't have to hard-code the numeric HCI error table themselves.
+type ErrCategory int
+
+// Error categories for ErrCommand. CategoryOther is the zero value and
+// covers codes that don't fall cleanly into one of the other classes.
+const (
+	CategoryOther ErrCategory = iota
+	CategoryTimeout
+	CategorySecurity
+	CategoryResource
+	CategoryRemote
+	CategoryUnsupported
+	CategoryInvalidParam
+)
+
+// Category-only sentinel values usable with errors.Is, e.g.
+//
+//	if errors.Is(err, hci.ErrTimeoutCategory) {
+//		// back off and retry
+//	}
+//
+// matches any ErrCommand whose Category() is CategoryTimeout.
+var (
+	ErrTimeoutCategory      = CategoryTimeout
+	ErrSecurityCategory     = CategorySecurity
+	ErrResourceCategory     = CategoryResource
+	ErrRemoteCategory       = CategoryRemote
+	ErrUnsupportedCategory  = CategoryUnsupported
+	ErrInvalidParamCategory = CategoryInvalidParam
+)
+
+func (c ErrCategory) Error() string {
+	switch c {
+	case CategoryTimeout:
+		return "hci: timeout error"
+	case CategorySecurity:
+		return "hci: security error"
+	case CategoryResource:
+		return "hci: resource error"
+	case CategoryRemote:
+		return "hci: remote-initiated error"
+	case CategoryUnsupported:
+		return "hci: unsupported feature or parameter error"
+	case CategoryInvalidParam:
+		return "hci: invalid parameter error"
+	default:
+		return "hci: other error"
+	}
+}
+
+// Category returns the broad class e falls into.
+func (e ErrCommand) Category() ErrCategory {
+	switch e {
+	case ErrPageTimeout, ErrConnTimeout, ErrConnAcceptTimeout, ErrLLResponseTimeout, ErrControllerBusy, ErrDirAdvTimeout:
+		return CategoryTimeout
+	case ErrAuth, ErrPINMissing, ErrSecurity, ErrPairingNotAllowed, ErrEncNotAccepted, ErrInsufficientSecurity, ErrSecureSimplePairing, ErrMIC:
+		return CategorySecurity
+	case ErrMemoryCapacity, ErrConnLimit, ErrSCOConnLimit, ErrLimitedResource, ErrHostBusy, ErrNoChannel:
+		return CategoryResource
+	case ErrRemoteUser, ErrRemoteLowResources, ErrRemotePowerOff:
+		return CategoryRemote
+	case ErrUnknownCommand, ErrUnsupportedParams, ErrUnsupportedLMP, ErrUnsupportedLLParams, ErrUnitKeyNotSupported, ErrQoSNotSupported, ErrChannelClass:
+		return CategoryUnsupported
+	case ErrInvalidParams, ErrInvalidLLParams, ErrOutOfRange, ErrConnParams:
+		return CategoryInvalidParam
+	default:
+		return CategoryOther
+	}
+}
+
+// Is implements the errors.Is interface against the Category sentinels
+// above, so a caller can test for an entire class of HCI errors without
+// enumerating the individual codes in it.
+func (e ErrCommand) Is(target error) bool {
+	cat, ok := target.(ErrCategory)
+	return ok && e.Category() == cat
+}
+
+// Retryable reports whether e represents a transient condition (a page,
+// connection, connection-accept or LL response timeout, or a busy
+// controller) worth retrying with backoff, as opposed to, say, a security
+// or auth failure that will fail again on retry. ErrDirAdvTimeout is in
+// CategoryTimeout but is not reported as retryable.
+func (e ErrCommand) Retryable() bool {
+	switch e {
+	case ErrPageTimeout, ErrConnTimeout, ErrConnAcceptTimeout, ErrLLResponseTimeout, ErrControllerBusy:
+		return true
+	default:
+		return false
+	}
+}
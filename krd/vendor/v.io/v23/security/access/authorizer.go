@@ -17,11 +17,54 @@ const pkgPath = "v.io/v23/security/access"
 
 var (
 	errTagNeedsString              = verror.Register(pkgPath+".errTagNeedsString", verror.NoRetry, "{1:}{2:}tag type({3}) must be backed by a string not {4}{:_}")
-	errNoMethodTags                = verror.Register(pkgPath+".errNoMethodTags", verror.NoRetry, "{1:}{2:}PermissionsAuthorizer.Authorize called on {3}.{4}, which has no tags of type {5}; this is likely unintentional{:_}")
-	errMultipleMethodTags          = verror.Register(pkgPath+".errMultipleMethodTags", verror.NoRetry, "{1:}{2:}PermissionsAuthorizer on {3}.{4} cannot handle multiple tags of type {5} ({6}); this is likely unintentional{:_}")
 	errCantReadPermissionsFromFile = verror.Register(pkgPath+".errCantReadPermissionsFromFile", verror.NoRetry, "{1:}{2:}failed to read Permissions from file{:_}")
+
+	// ErrNoMethodTags means that PermissionsAuthorizer.Authorize was called
+	// on a method with no tags of the authorizer's tag type.
+	ErrNoMethodTags = verror.Register(pkgPath+".ErrNoMethodTags", verror.NoRetry, "{1:}{2:}PermissionsAuthorizer.Authorize called on {3}.{4}, which has no tags of type {5}; this is likely unintentional{:_}")
+	// ErrMultipleMethodTags means that PermissionsAuthorizer.Authorize was
+	// called on a method with more than one tag of the authorizer's tag
+	// type.
+	ErrMultipleMethodTags = verror.Register(pkgPath+".ErrMultipleMethodTags", verror.NoRetry, "{1:}{2:}PermissionsAuthorizer on {3}.{4} cannot handle multiple tags of type {5} ({6}); this is likely unintentional{:_}")
 )
 
+// IsNoMethodTags checks if err has the identifier ErrNoMethodTags.ID, and if
+// so returns the method that was called with no tags. It returns ""
+// otherwise.
+func IsNoMethodTags(err error) string {
+	if verror.ErrorID(err) != ErrNoMethodTags.ID {
+		return ""
+	}
+	verr, ok := err.(verror.E)
+	if !ok || len(verr.ParamList) != 5 {
+		return ""
+	}
+	method, ok := verr.ParamList[3].(string)
+	if !ok {
+		return ""
+	}
+	return method
+}
+
+// IsMultipleMethodTags checks if err has the identifier
+// ErrMultipleMethodTags.ID, and if so returns the method that was called
+// with more than one tag of the authorizer's tag type. It returns ""
+// otherwise.
+func IsMultipleMethodTags(err error) string {
+	if verror.ErrorID(err) != ErrMultipleMethodTags.ID {
+		return ""
+	}
+	verr, ok := err.(verror.E)
+	if !ok || len(verr.ParamList) != 6 {
+		return ""
+	}
+	method, ok := verr.ParamList[3].(string)
+	if !ok {
+		return ""
+	}
+	return method
+}
+
 // PermissionsAuthorizer implements an authorization policy where access is
 // granted if the remote end presents blessings included in the Access Control
 // Lists (AccessLists) associated with the set of relevant tags.
@@ -125,7 +168,7 @@ func (a *authorizer) Authorize(ctx *context.T, call security.Call) error {
 	for _, tag := range call.MethodTags() {
 		if tag.Type() == a.tagType {
 			if hastag {
-				return verror.New(errMultipleMethodTags, ctx, call.Suffix(), call.Method(), a.tagType, call.MethodTags())
+				return verror.New(ErrMultipleMethodTags, ctx, call.Suffix(), call.Method(), a.tagType, call.MethodTags())
 			}
 			hastag = true
 			if acl, exists := a.perms[tag.RawString()]; !exists || !acl.Includes(blessings...) {
@@ -134,7 +177,7 @@ func (a *authorizer) Authorize(ctx *context.T, call security.Call) error {
 		}
 	}
 	if !hastag {
-		return verror.New(errNoMethodTags, ctx, call.Suffix(), call.Method(), a.tagType)
+		return verror.New(ErrNoMethodTags, ctx, call.Suffix(), call.Method(), a.tagType)
 	}
 	return nil
 }